@@ -0,0 +1,103 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceComputeAggregateV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeAggregateV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"hosts": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"computed_metadata": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeAggregateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	allPages, err := aggregates.List(computeClient).AllPages()
+	if err != nil {
+		return fmt.Errorf("Error listing OpenStack aggregates: %s", err)
+	}
+
+	allAggregates, err := aggregates.ExtractAggregates(allPages)
+	if err != nil {
+		return fmt.Errorf("Error extracting OpenStack aggregates: %s", err)
+	}
+
+	name := d.Get("name").(string)
+	zone := d.Get("zone").(string)
+
+	var matches []aggregates.Aggregate
+	for _, aggregate := range allAggregates {
+		if aggregate.Name != name {
+			continue
+		}
+		if zone != "" && aggregate.AvailabilityZone != zone {
+			continue
+		}
+		matches = append(matches, aggregate)
+	}
+
+	if len(matches) < 1 {
+		return fmt.Errorf("Your query returned no results. Please change your search criteria and try again")
+	}
+
+	if len(matches) > 1 {
+		return fmt.Errorf("Your query returned more than one result. Please try a more specific search criteria")
+	}
+
+	aggregate := matches[0]
+
+	d.SetId(fmt.Sprintf("%d", aggregate.ID))
+	d.Set("computed_metadata", aggregate.Metadata)
+
+	// Metadata is redundant with Availability Zone
+	metadata := aggregate.Metadata
+	delete(metadata, "availability_zone")
+
+	d.Set("name", aggregate.Name)
+	d.Set("zone", aggregate.AvailabilityZone)
+	d.Set("hosts", aggregate.Hosts)
+	d.Set("metadata", metadata)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceComputeAggregatesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeAggregatesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"zone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metadata": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+
+			"ids": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"aggregates": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"hosts": {
+							Type:     schema.TypeSet,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceComputeAggregatesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.ComputeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	allPages, err := aggregates.List(computeClient).AllPages()
+	if err != nil {
+		return fmt.Errorf("Error listing OpenStack aggregates: %s", err)
+	}
+
+	allAggregates, err := aggregates.ExtractAggregates(allPages)
+	if err != nil {
+		return fmt.Errorf("Error extracting OpenStack aggregates: %s", err)
+	}
+
+	zone := d.Get("zone").(string)
+	filterMetadata := d.Get("metadata").(map[string]interface{})
+
+	var ids, names []string
+	var result []map[string]interface{}
+	for _, aggregate := range allAggregates {
+		if zone != "" && aggregate.AvailabilityZone != zone {
+			continue
+		}
+
+		if !aggregateMatchesMetadata(aggregate.Metadata, filterMetadata) {
+			continue
+		}
+
+		id := fmt.Sprintf("%d", aggregate.ID)
+		ids = append(ids, id)
+		names = append(names, aggregate.Name)
+		result = append(result, map[string]interface{}{
+			"id":    id,
+			"name":  aggregate.Name,
+			"hosts": aggregate.Hosts,
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d", len(ids)))
+	d.Set("ids", ids)
+	d.Set("names", names)
+	d.Set("aggregates", result)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func aggregateMatchesMetadata(metadata map[string]string, filter map[string]interface{}) bool {
+	for key, value := range filter {
+		if metadata[key] != value.(string) {
+			return false
+		}
+	}
+	return true
+}
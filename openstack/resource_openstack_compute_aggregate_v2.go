@@ -40,8 +40,13 @@ func resourceComputeAggregateV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"computed_metadata": {
+				Type:     schema.TypeMap,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
 			"hosts": {
-				Type:     schema.TypeList,
+				Type:     schema.TypeSet,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 				Optional: true,
 				Computed: true,
@@ -69,8 +74,8 @@ func resourceComputeAggregateV2Create(d *schema.ResourceData, meta interface{})
 
 	hosts, ok := d.GetOk("hosts")
 	if ok {
-		for _, host := range hosts.([]string) {
-			_, err = aggregates.AddHost(computeClient, aggregate.ID, aggregates.AddHostOpts{Host: host}).Extract()
+		for _, host := range hosts.(*schema.Set).List() {
+			_, err = aggregates.AddHost(computeClient, aggregate.ID, aggregates.AddHostOpts{Host: host.(string)}).Extract()
 			if err != nil {
 				return fmt.Errorf("Error adding host %s to Openstack aggregate: %s", host, err)
 			}
@@ -102,6 +107,8 @@ func resourceComputeAggregateV2Read(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error getting host aggregate: %s", err)
 	}
 
+	d.Set("computed_metadata", aggregate.Metadata)
+
 	// Metadata is redundant with Availability Zone
 	metadata := aggregate.Metadata
 	_, ok := metadata["availability_zone"]
@@ -146,16 +153,16 @@ func resourceComputeAggregateV2Update(d *schema.ResourceData, meta interface{})
 
 	if d.HasChange("hosts") {
 		oldHosts, newHosts := d.GetChange("hosts")
-		hostsToDelete := arrayDifference(oldHosts, newHosts)
-		hostsToAdd := arrayDifference(newHosts, oldHosts)
-		for _, host := range hostsToDelete {
-			_, err = aggregates.RemoveHost(computeClient, id, aggregates.RemoveHostOpts{Host: host}).Extract()
+		hostsToDelete := oldHosts.(*schema.Set).Difference(newHosts.(*schema.Set))
+		hostsToAdd := newHosts.(*schema.Set).Difference(oldHosts.(*schema.Set))
+		for _, host := range hostsToDelete.List() {
+			_, err = aggregates.RemoveHost(computeClient, id, aggregates.RemoveHostOpts{Host: host.(string)}).Extract()
 			if err != nil {
-				return fmt.Errorf("Error adding host %s to Openstack aggregate: %s", host, err)
+				return fmt.Errorf("Error removing host %s from Openstack aggregate: %s", host, err)
 			}
 		}
-		for _, host := range hostsToAdd {
-			_, err = aggregates.AddHost(computeClient, id, aggregates.AddHostOpts{Host: host}).Extract()
+		for _, host := range hostsToAdd.List() {
+			_, err = aggregates.AddHost(computeClient, id, aggregates.AddHostOpts{Host: host.(string)}).Extract()
 			if err != nil {
 				return fmt.Errorf("Error adding host %s to Openstack aggregate: %s", host, err)
 			}
@@ -163,7 +170,24 @@ func resourceComputeAggregateV2Update(d *schema.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("metadata") {
-		_, err = aggregates.SetMetadata(computeClient, id, aggregates.SetMetadataOpts{Metadata: d.Get("metadata").(map[string]interface{})}).Extract()
+		oldMetadata, newMetadata := d.GetChange("metadata")
+		oldMetadataMap := oldMetadata.(map[string]interface{})
+		newMetadataMap := newMetadata.(map[string]interface{})
+
+		metadata := make(map[string]interface{})
+		for key, value := range newMetadataMap {
+			metadata[key] = value
+		}
+		// Keys that were removed from the config must be sent back as empty
+		// strings, since the Nova API treats an omitted key as "unchanged"
+		// and an empty string as "delete this key".
+		for key := range oldMetadataMap {
+			if _, ok := newMetadataMap[key]; !ok {
+				metadata[key] = ""
+			}
+		}
+
+		_, err = aggregates.SetMetadata(computeClient, id, aggregates.SetMetadataOpts{Metadata: metadata}).Extract()
 		if err != nil {
 			return fmt.Errorf("Error setting metadata: %s", err)
 		}
@@ -191,18 +215,3 @@ func resourceComputeAggregateV2Delete(d *schema.ResourceData, meta interface{})
 
 	return nil
 }
-
-func arrayDifference(a, b interface{}) (diff []string) {
-	m := make(map[string]bool)
-
-	for _, item := range b.([]string) {
-		m[item] = true
-	}
-	for _, item := range a.([]string) {
-		_, ok := m[item]
-		if !ok {
-			diff = append(diff, item)
-		}
-	}
-	return
-}
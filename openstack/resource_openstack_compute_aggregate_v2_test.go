@@ -0,0 +1,120 @@
+package openstack
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/aggregates"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccComputeV2Aggregate_hosts(t *testing.T) {
+	var aggregate aggregates.Aggregate
+
+	computeHost := os.Getenv("OS_COMPUTE_HOST")
+	if computeHost == "" {
+		t.Skip("OS_COMPUTE_HOST must be set for this acceptance test")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckCompute(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeAggregateV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeAggregateV2HostsAdd(computeHost),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeAggregateV2Exists("openstack_compute_aggregate_v2.test_aggregate", &aggregate),
+					resource.TestCheckResourceAttr("openstack_compute_aggregate_v2.test_aggregate", "hosts.#", "1"),
+				),
+			},
+			{
+				Config: testAccComputeAggregateV2HostsRemove,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeAggregateV2Exists("openstack_compute_aggregate_v2.test_aggregate", &aggregate),
+					resource.TestCheckResourceAttr("openstack_compute_aggregate_v2.test_aggregate", "hosts.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeAggregateV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	computeClient, err := config.ComputeV2Client(osRegionName)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_compute_aggregate_v2" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't convert ID to integer: %s", err)
+		}
+
+		_, err = aggregates.Get(computeClient, id).Extract()
+		if err == nil {
+			return fmt.Errorf("Aggregate still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeAggregateV2Exists(n string, aggregate *aggregates.Aggregate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.ComputeV2Client(osRegionName)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Can't convert ID to integer: %s", err)
+		}
+
+		found, err := aggregates.Get(computeClient, id).Extract()
+		if err != nil {
+			return err
+		}
+
+		*aggregate = *found
+
+		return nil
+	}
+}
+
+func testAccComputeAggregateV2HostsAdd(host string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_aggregate_v2" "test_aggregate" {
+  name  = "test-aggregate"
+  zone  = "nova"
+  hosts = ["%s"]
+}
+`, host)
+}
+
+const testAccComputeAggregateV2HostsRemove = `
+resource "openstack_compute_aggregate_v2" "test_aggregate" {
+  name  = "test-aggregate"
+  zone  = "nova"
+  hosts = []
+}
+`